@@ -0,0 +1,13 @@
+package ladon
+
+// AuditLogger is notified of every decision Ladon makes, so that callers can log denials
+// (or allows) for security review.
+type AuditLogger interface {
+	LogIsAllowed(d *Decision)
+}
+
+// NoopAuditLogger is the default AuditLogger: it discards every decision.
+type NoopAuditLogger struct{}
+
+// LogIsAllowed does nothing.
+func (NoopAuditLogger) LogIsAllowed(*Decision) {}
@@ -0,0 +1,55 @@
+// Package chain implements an alternative, ordered evaluation mode for ladon policies.
+// Where Ladon.IsAllowed applies deny-overrides-allow semantics across every matching
+// policy, a Chain evaluates its Rules top-to-bottom and stops at the first one that
+// matches, much like an S3 bucket policy or a firewall rule list.
+package chain
+
+import "github.com/olivierdeckers/ladon"
+
+// Status is the outcome a matched Rule produces.
+type Status string
+
+const (
+	// Allow grants the request.
+	Allow Status = "allow"
+
+	// AccessDenied explicitly denies the request.
+	AccessDenied Status = "access_denied"
+
+	// Quota denies the request because a quota was exceeded.
+	Quota Status = "quota"
+)
+
+// Actions is the set of action patterns a Rule matches against.
+type Actions struct {
+	// Inverted, when true, makes the rule match every action except the ones in Names.
+	Inverted bool
+	Names    []string
+}
+
+// Resources is the set of resource patterns a Rule matches against.
+type Resources struct {
+	// Inverted, when true, makes the rule match every resource except the ones in Names.
+	Inverted bool
+	Names    []string
+}
+
+// Rule is a single entry in a Chain. The first Rule in a Chain whose Actions, Resources
+// and Conditions apply to a request determines its Status.
+type Rule struct {
+	ID        string
+	Status    Status
+	Actions   Actions
+	Resources Resources
+
+	// Any, when true, requires only one of Conditions to match instead of all of them.
+	Any        bool
+	Conditions ladon.Conditions
+}
+
+// Chain is an ordered list of Rules.
+type Chain struct {
+	ID          string
+	Description string
+	Rules       []Rule
+}
@@ -0,0 +1,148 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/olivierdeckers/ladon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A bucket policy chain resembling S3 bucket-policy resolution: explicit denies and quota
+// rules are listed ahead of the catch-all allow, so the first matching rule wins.
+var bucketChain = &Chain{
+	ID:          "bucket-policy",
+	Description: "Resolves access to the objects in a single S3-like bucket",
+	Rules: []Rule{
+		{
+			ID:        "deny-delete-without-owner",
+			Status:    AccessDenied,
+			Actions:   Actions{Names: []string{"delete"}},
+			Resources: Resources{Names: []string{"<.*>"}},
+			Conditions: ladon.Conditions{
+				"owner": &ladon.NotDefinedCondition{},
+			},
+		},
+		{
+			ID:        "quota-on-uploads",
+			Status:    Quota,
+			Actions:   Actions{Names: []string{"put"}},
+			Resources: Resources{Names: []string{"<.*>"}},
+			Conditions: ladon.Conditions{
+				"overQuota": &ladon.BoolCondition{Value: true},
+			},
+		},
+		{
+			ID:        "allow-everything-else",
+			Status:    Allow,
+			Actions:   Actions{Names: []string{"<.*>"}},
+			Resources: Resources{Names: []string{"<.*>"}},
+		},
+	},
+}
+
+func newWarden(t *testing.T) *ChainWarden {
+	manager := NewMemoryChainManager()
+	require.Nil(t, manager.Create(bucketChain))
+	return &ChainWarden{Manager: manager}
+}
+
+func TestChainWardenFirstMatchWins(t *testing.T) {
+	warden := newWarden(t)
+
+	status, ruleID, err := warden.Evaluate(&ladon.Request{
+		Action:   "delete",
+		Resource: "bucket/key",
+		Context:  ladon.Context{},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, AccessDenied, status)
+	assert.Equal(t, "deny-delete-without-owner", ruleID)
+
+	status, ruleID, err = warden.Evaluate(&ladon.Request{
+		Action:   "delete",
+		Resource: "bucket/key",
+		Context:  ladon.Context{"owner": "max"},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, Allow, status)
+	assert.Equal(t, "allow-everything-else", ruleID)
+}
+
+func TestChainWardenQuota(t *testing.T) {
+	warden := newWarden(t)
+
+	status, ruleID, err := warden.Evaluate(&ladon.Request{
+		Action:   "put",
+		Resource: "bucket/key",
+		Context:  ladon.Context{"overQuota": true},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, Quota, status)
+	assert.Equal(t, "quota-on-uploads", ruleID)
+}
+
+func TestChainWardenInvertedActions(t *testing.T) {
+	manager := NewMemoryChainManager()
+	require.Nil(t, manager.Create(&Chain{
+		ID: "deny-everything-but-get",
+		Rules: []Rule{
+			{
+				ID:        "deny-non-get",
+				Status:    AccessDenied,
+				Actions:   Actions{Inverted: true, Names: []string{"get"}},
+				Resources: Resources{Names: []string{"<.*>"}},
+			},
+			{
+				ID:        "allow-get",
+				Status:    Allow,
+				Actions:   Actions{Names: []string{"get"}},
+				Resources: Resources{Names: []string{"<.*>"}},
+			},
+		},
+	}))
+	warden := &ChainWarden{Manager: manager}
+
+	status, ruleID, err := warden.Evaluate(&ladon.Request{Action: "delete", Resource: "x"})
+	require.Nil(t, err)
+	assert.Equal(t, AccessDenied, status)
+	assert.Equal(t, "deny-non-get", ruleID)
+
+	status, ruleID, err = warden.Evaluate(&ladon.Request{Action: "get", Resource: "x"})
+	require.Nil(t, err)
+	assert.Equal(t, Allow, status)
+	assert.Equal(t, "allow-get", ruleID)
+}
+
+func TestChainWardenNoRuleMatched(t *testing.T) {
+	warden := &ChainWarden{Manager: NewMemoryChainManager()}
+	_, _, err := warden.Evaluate(&ladon.Request{Action: "get", Resource: "x"})
+	assert.NotNil(t, err)
+}
+
+// TestChainWardenOrdersChainsByID guards against Evaluate becoming order-dependent on Go's
+// randomized map iteration: with two chains that both match the same request but disagree
+// on the outcome, the lexicographically first chain ID must win every time.
+func TestChainWardenOrdersChainsByID(t *testing.T) {
+	manager := NewMemoryChainManager()
+	require.Nil(t, manager.Create(&Chain{
+		ID: "a-deny-everything",
+		Rules: []Rule{
+			{ID: "deny", Status: AccessDenied, Actions: Actions{Names: []string{"<.*>"}}, Resources: Resources{Names: []string{"<.*>"}}},
+		},
+	}))
+	require.Nil(t, manager.Create(&Chain{
+		ID: "z-allow-everything",
+		Rules: []Rule{
+			{ID: "allow", Status: Allow, Actions: Actions{Names: []string{"<.*>"}}, Resources: Resources{Names: []string{"<.*>"}}},
+		},
+	}))
+	warden := &ChainWarden{Manager: manager}
+
+	for i := 0; i < 20; i++ {
+		status, ruleID, err := warden.Evaluate(&ladon.Request{Action: "get", Resource: "x"})
+		require.Nil(t, err)
+		assert.Equal(t, AccessDenied, status)
+		assert.Equal(t, "deny", ruleID)
+	}
+}
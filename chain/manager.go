@@ -0,0 +1,25 @@
+package chain
+
+import "github.com/olivierdeckers/ladon"
+
+// ChainManager is responsible for storing and retrieving chains, mirroring ladon.Manager.
+type ChainManager interface {
+	// Create persists a new chain.
+	Create(c *Chain) error
+
+	// Update updates an existing chain.
+	Update(c *Chain) error
+
+	// Get retrieves a chain by id.
+	Get(id string) (*Chain, error)
+
+	// Delete removes a chain by id.
+	Delete(id string) error
+
+	// GetAll returns all chains, honoring limit and offset.
+	GetAll(limit, offset int64) ([]*Chain, error)
+
+	// FindRequestCandidates returns the chains that could possibly apply to r. The
+	// ChainWarden is responsible for the actual rule-by-rule matching.
+	FindRequestCandidates(r *ladon.Request) ([]*Chain, error)
+}
@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/olivierdeckers/ladon"
+	"github.com/pkg/errors"
+)
+
+// MemoryChainManager is an in-memory (non-persistent) implementation of ChainManager,
+// useful for tests and small deployments.
+type MemoryChainManager struct {
+	Chains map[string]*Chain
+	sync.RWMutex
+}
+
+// NewMemoryChainManager returns an empty MemoryChainManager.
+func NewMemoryChainManager() *MemoryChainManager {
+	return &MemoryChainManager{Chains: map[string]*Chain{}}
+}
+
+// Create persists a new chain.
+func (m *MemoryChainManager) Create(c *Chain) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, found := m.Chains[c.ID]; found {
+		return errors.Errorf("chain with id %s already exists", c.ID)
+	}
+
+	m.Chains[c.ID] = c
+	return nil
+}
+
+// Update updates an existing chain.
+func (m *MemoryChainManager) Update(c *Chain) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.Chains[c.ID] = c
+	return nil
+}
+
+// Get retrieves a chain by id.
+func (m *MemoryChainManager) Get(id string) (*Chain, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	c, ok := m.Chains[id]
+	if !ok {
+		return nil, errors.Errorf("chain %s not found", id)
+	}
+	return c, nil
+}
+
+// Delete removes a chain by id.
+func (m *MemoryChainManager) Delete(id string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.Chains, id)
+	return nil
+}
+
+// GetAll returns all chains, honoring limit and offset.
+func (m *MemoryChainManager) GetAll(limit, offset int64) ([]*Chain, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	all := make([]*Chain, 0, len(m.Chains))
+	for _, c := range m.Chains {
+		all = append(all, c)
+	}
+
+	start := offset
+	if start > int64(len(all)) {
+		start = int64(len(all))
+	}
+	end := start + limit
+	if limit <= 0 || end > int64(len(all)) {
+		end = int64(len(all))
+	}
+	return all[start:end], nil
+}
+
+// FindRequestCandidates returns every stored chain, ordered by ID; MemoryChainManager is
+// too small to benefit from narrowing the candidate set further. The ordering is load-
+// bearing: ChainWarden.Evaluate stops at the first matching Rule of the first matching
+// Chain, so candidates must come back in a stable order rather than Go's randomized map
+// iteration order.
+func (m *MemoryChainManager) FindRequestCandidates(r *ladon.Request) ([]*Chain, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	all := make([]*Chain, 0, len(m.Chains))
+	for _, c := range m.Chains {
+		all = append(all, c)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+	return all, nil
+}
@@ -0,0 +1,86 @@
+package chain
+
+import (
+	"github.com/olivierdeckers/ladon"
+	"github.com/pkg/errors"
+)
+
+// ChainWarden evaluates a Request against the Chains returned by Manager, stopping at the
+// first Rule of the first Chain that matches. This is distinct from ladon.Ladon.IsAllowed,
+// which applies deny-overrides-allow semantics across every matching policy.
+type ChainWarden struct {
+	Manager ChainManager
+	Matcher ladon.Matcher
+}
+
+// Evaluate returns the Status and id of the first Rule, across every Chain Manager
+// considers a candidate, whose Actions, Resources and Conditions apply to r.
+func (w *ChainWarden) Evaluate(r *ladon.Request) (Status, string, error) {
+	chains, err := w.Manager.FindRequestCandidates(r)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, c := range chains {
+		for _, rule := range c.Rules {
+			if !w.matchesRule(rule, r) {
+				continue
+			}
+			return rule.Status, rule.ID, nil
+		}
+	}
+
+	return "", "", errors.New("no rule matched the request")
+}
+
+func (w *ChainWarden) matchesRule(rule Rule, r *ladon.Request) bool {
+	if !w.matchesSet(rule.Actions.Inverted, rule.Actions.Names, r.Action) {
+		return false
+	}
+	if !w.matchesSet(rule.Resources.Inverted, rule.Resources.Names, r.Resource) {
+		return false
+	}
+	return w.passesConditions(rule, r)
+}
+
+// matchesSet returns true if needle matches one of names, or, when inverted is true, if it
+// matches none of them.
+func (w *ChainWarden) matchesSet(inverted bool, names []string, needle string) bool {
+	matched := w.matcher().Matches(nil, names, needle)
+	if inverted {
+		return !matched
+	}
+	return matched
+}
+
+// passesConditions returns true if r's context fulfills rule's conditions: all of them by
+// default, or just one if rule.Any is true. Conditions see a nil value for context keys that
+// are absent from r, so e.g. ladon.NotDefinedCondition can match on their absence.
+func (w *ChainWarden) passesConditions(rule Rule, r *ladon.Request) bool {
+	if len(rule.Conditions) == 0 {
+		return true
+	}
+
+	for key, condition := range rule.Conditions {
+		fulfilled := condition.Fulfills(r.Context[key], r)
+
+		if fulfilled && rule.Any {
+			return true
+		}
+		if !fulfilled && !rule.Any {
+			return false
+		}
+	}
+
+	return !rule.Any
+}
+
+// matcher returns w.Matcher, or a fresh DefaultMatcher if unset. It does not cache the
+// default back onto w: ChainWarden is a long-lived value shared across concurrent requests,
+// and writing to w.Matcher here without synchronization would race with concurrent reads.
+func (w *ChainWarden) matcher() ladon.Matcher {
+	if w.Matcher == nil {
+		return ladon.NewDefaultMatcher()
+	}
+	return w.Matcher
+}
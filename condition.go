@@ -0,0 +1,122 @@
+package ladon
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Condition is an abstraction of a policy condition.
+type Condition interface {
+	// Fulfills returns true if the request's context value fulfills the condition.
+	Fulfills(interface{}, *Request) bool
+
+	// GetName returns the condition's name.
+	GetName() string
+}
+
+// Conditions is a collection of conditions, indexed by the context key they apply to.
+type Conditions map[string]Condition
+
+// AddCondition adds a condition to the collection.
+func (cs Conditions) AddCondition(key string, c Condition) {
+	cs[key] = c
+}
+
+type jsonCondition struct {
+	Type    string          `json:"type"`
+	Options json.RawMessage `json:"options"`
+}
+
+// newCondition returns a zero-value Condition for the given GetName(), so callers can
+// unmarshal directly into it.
+func newCondition(name string) (Condition, error) {
+	switch name {
+	case new(CIDRCondition).GetName():
+		return new(CIDRCondition), nil
+	case new(StringEqualCondition).GetName():
+		return new(StringEqualCondition), nil
+	case new(EqualsSubjectCondition).GetName():
+		return new(EqualsSubjectCondition), nil
+	case new(DefinedCondition).GetName():
+		return new(DefinedCondition), nil
+	case new(NotDefinedCondition).GetName():
+		return new(NotDefinedCondition), nil
+	case new(StringNotEqualsCondition).GetName():
+		return new(StringNotEqualsCondition), nil
+	case new(StringEqualsIgnoreCaseCondition).GetName():
+		return new(StringEqualsIgnoreCaseCondition), nil
+	case new(StringLikeCondition).GetName():
+		return new(StringLikeCondition), nil
+	case new(StringNotLikeCondition).GetName():
+		return new(StringNotLikeCondition), nil
+	case new(NumericEqualsCondition).GetName():
+		return new(NumericEqualsCondition), nil
+	case new(NumericNotEqualsCondition).GetName():
+		return new(NumericNotEqualsCondition), nil
+	case new(NumericLessThanCondition).GetName():
+		return new(NumericLessThanCondition), nil
+	case new(NumericLessThanEqualsCondition).GetName():
+		return new(NumericLessThanEqualsCondition), nil
+	case new(NumericGreaterThanCondition).GetName():
+		return new(NumericGreaterThanCondition), nil
+	case new(NumericGreaterThanEqualsCondition).GetName():
+		return new(NumericGreaterThanEqualsCondition), nil
+	case new(DateEqualsCondition).GetName():
+		return new(DateEqualsCondition), nil
+	case new(DateLessThanCondition).GetName():
+		return new(DateLessThanCondition), nil
+	case new(DateGreaterThanCondition).GetName():
+		return new(DateGreaterThanCondition), nil
+	case new(BoolCondition).GetName():
+		return new(BoolCondition), nil
+	case new(IpAddressCondition).GetName():
+		return new(IpAddressCondition), nil
+	case new(NotIpAddressCondition).GetName():
+		return new(NotIpAddressCondition), nil
+	case new(ArnEqualsCondition).GetName():
+		return new(ArnEqualsCondition), nil
+	case new(ArnLikeCondition).GetName():
+		return new(ArnLikeCondition), nil
+	default:
+		return nil, errors.Errorf("could not find condition type %s", name)
+	}
+}
+
+// UnmarshalJSON populates cs from `{"key": {"type": "...", "options": {...}}}`, looking up
+// the concrete Condition by its GetName().
+func (cs *Conditions) UnmarshalJSON(data []byte) error {
+	var raw map[string]jsonCondition
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*cs = Conditions{}
+	for key, c := range raw {
+		condition, err := newCondition(c.Type)
+		if err != nil {
+			return err
+		}
+
+		if len(c.Options) > 0 {
+			if err := json.Unmarshal(c.Options, condition); err != nil {
+				return err
+			}
+		}
+		(*cs)[key] = condition
+	}
+	return nil
+}
+
+// MarshalJSON renders cs as `{"key": {"type": "...", "options": {...}}}`.
+func (cs Conditions) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]jsonCondition, len(cs))
+	for key, condition := range cs {
+		options, err := json.Marshal(condition)
+		if err != nil {
+			return nil, err
+		}
+		raw[key] = jsonCondition{Type: condition.GetName(), Options: options}
+	}
+	return json.Marshal(raw)
+}
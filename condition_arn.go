@@ -0,0 +1,96 @@
+package ladon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Arn is a parsed "arn:partition:service:region:account:resource" identifier.
+type Arn struct {
+	Partition string
+	Service   string
+	Region    string
+	Account   string
+	Resource  string
+}
+
+// ParseArn parses s into an Arn, returning an error if it doesn't have the
+// "arn:partition:service:region:account:resource" shape.
+func ParseArn(s string) (*Arn, error) {
+	parts := strings.SplitN(s, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return nil, errors.Errorf("%q is not a valid ARN", s)
+	}
+
+	return &Arn{
+		Partition: parts[1],
+		Service:   parts[2],
+		Region:    parts[3],
+		Account:   parts[4],
+		Resource:  parts[5],
+	}, nil
+}
+
+// ArnEqualsCondition is fulfilled if the given value is an ARN identical to Arn.
+type ArnEqualsCondition struct {
+	Arn string `json:"arn"`
+}
+
+// Fulfills returns true if value is an ARN equal to c.Arn.
+func (c *ArnEqualsCondition) Fulfills(value interface{}, _ *Request) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+
+	want, err := ParseArn(c.Arn)
+	if err != nil {
+		return false
+	}
+	got, err := ParseArn(s)
+	if err != nil {
+		return false
+	}
+	return *want == *got
+}
+
+// GetName returns the condition's name.
+func (c *ArnEqualsCondition) GetName() string {
+	return "ArnEqualsCondition"
+}
+
+// Reason explains why value was not an ARN equal to c.Arn.
+func (c *ArnEqualsCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not an ARN equal to %s", value, c.Arn)
+}
+
+// ArnLikeCondition is fulfilled if the given value is an ARN matching Pattern, a glob that
+// supports '*' and '?' in each of its colon-separated fields.
+type ArnLikeCondition struct {
+	Pattern string `json:"pattern"`
+}
+
+// Fulfills returns true if value is an ARN matching c.Pattern.
+func (c *ArnLikeCondition) Fulfills(value interface{}, _ *Request) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+
+	if _, err := ParseArn(s); err != nil {
+		return false
+	}
+	return globMatch(c.Pattern, s)
+}
+
+// GetName returns the condition's name.
+func (c *ArnLikeCondition) GetName() string {
+	return "ArnLikeCondition"
+}
+
+// Reason explains why value was not an ARN matching c.Pattern.
+func (c *ArnLikeCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not an ARN matching pattern %q", value, c.Pattern)
+}
@@ -0,0 +1,152 @@
+package ladon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringConditions(t *testing.T) {
+	for k, tc := range []struct {
+		c        Condition
+		value    interface{}
+		fulfills bool
+	}{
+		{&StringNotEqualsCondition{Equals: "admin"}, "user", true},
+		{&StringNotEqualsCondition{Equals: "admin"}, "admin", false},
+		{&StringNotEqualsCondition{Equals: "admin"}, 1, false},
+		{&StringEqualsIgnoreCaseCondition{Equals: "Admin"}, "admin", true},
+		{&StringEqualsIgnoreCaseCondition{Equals: "Admin"}, "user", false},
+		{&StringLikeCondition{Pattern: "myrn:*:resource:?"}, "myrn:some.domain.com:resource:1", true},
+		{&StringLikeCondition{Pattern: "myrn:*:resource:?"}, "myrn:some.domain.com:resource:12", false},
+		{&StringNotLikeCondition{Pattern: "myrn:*:resource:?"}, "myrn:some.domain.com:resource:12", true},
+		{&StringNotLikeCondition{Pattern: "myrn:*:resource:?"}, "myrn:some.domain.com:resource:1", false},
+	} {
+		assert.Equal(t, tc.fulfills, tc.c.Fulfills(tc.value, &Request{}), "case %d", k)
+	}
+}
+
+func TestNumericConditions(t *testing.T) {
+	for k, tc := range []struct {
+		c        Condition
+		value    interface{}
+		fulfills bool
+	}{
+		{&NumericEqualsCondition{Value: 10}, 10, true},
+		{&NumericEqualsCondition{Value: 10}, json.Number("10"), true},
+		{&NumericEqualsCondition{Value: 10}, 11, false},
+		{&NumericNotEqualsCondition{Value: 10}, 11, true},
+		{&NumericNotEqualsCondition{Value: 10}, 10, false},
+		{&NumericLessThanCondition{Value: 10}, 9.5, true},
+		{&NumericLessThanCondition{Value: 10}, 10, false},
+		{&NumericLessThanEqualsCondition{Value: 10}, 10, true},
+		{&NumericLessThanEqualsCondition{Value: 10}, 11, false},
+		{&NumericGreaterThanCondition{Value: 10}, 11, true},
+		{&NumericGreaterThanCondition{Value: 10}, 10, false},
+		{&NumericGreaterThanEqualsCondition{Value: 10}, 10, true},
+		{&NumericGreaterThanEqualsCondition{Value: 10}, 9, false},
+		{&NumericEqualsCondition{Value: 10}, "10", false},
+	} {
+		assert.Equal(t, tc.fulfills, tc.c.Fulfills(tc.value, &Request{}), "case %d", k)
+	}
+}
+
+func TestDateConditions(t *testing.T) {
+	for k, tc := range []struct {
+		c        Condition
+		value    interface{}
+		fulfills bool
+	}{
+		{&DateEqualsCondition{Value: "2020-01-01T00:00:00Z"}, "2020-01-01T00:00:00Z", true},
+		{&DateEqualsCondition{Value: "2020-01-01T00:00:00Z"}, int64(1577836800), true},
+		{&DateLessThanCondition{Value: "2020-01-01T00:00:00Z"}, "2019-12-31T00:00:00Z", true},
+		{&DateLessThanCondition{Value: "2020-01-01T00:00:00Z"}, "2020-01-02T00:00:00Z", false},
+		{&DateGreaterThanCondition{Value: "2020-01-01T00:00:00Z"}, "2020-01-02T00:00:00Z", true},
+		{&DateGreaterThanCondition{Value: "2020-01-01T00:00:00Z"}, "2019-12-31T00:00:00Z", false},
+		{&DateEqualsCondition{Value: "2020-01-01T00:00:00Z"}, "not-a-date", false},
+	} {
+		assert.Equal(t, tc.fulfills, tc.c.Fulfills(tc.value, &Request{}), "case %d", k)
+	}
+}
+
+func TestBoolCondition(t *testing.T) {
+	assert.True(t, (&BoolCondition{Value: true}).Fulfills(true, &Request{}))
+	assert.False(t, (&BoolCondition{Value: true}).Fulfills(false, &Request{}))
+	assert.False(t, (&BoolCondition{Value: true}).Fulfills("true", &Request{}))
+}
+
+func TestIpAddressConditions(t *testing.T) {
+	for k, tc := range []struct {
+		c        Condition
+		value    interface{}
+		fulfills bool
+	}{
+		{&IpAddressCondition{CIDR: []string{"127.0.0.1/32", "::1/128"}}, "127.0.0.1", true},
+		{&IpAddressCondition{CIDR: []string{"127.0.0.1/32", "::1/128"}}, "::1", true},
+		{&IpAddressCondition{CIDR: []string{"127.0.0.1/32"}}, "0.0.0.0", false},
+		{&NotIpAddressCondition{CIDR: []string{"127.0.0.1/32"}}, "0.0.0.0", true},
+		{&NotIpAddressCondition{CIDR: []string{"127.0.0.1/32"}}, "127.0.0.1", false},
+	} {
+		assert.Equal(t, tc.fulfills, tc.c.Fulfills(tc.value, &Request{}), "case %d", k)
+	}
+}
+
+func TestArnConditions(t *testing.T) {
+	for k, tc := range []struct {
+		c        Condition
+		value    interface{}
+		fulfills bool
+	}{
+		{&ArnEqualsCondition{Arn: "arn:aws:s3:::my-bucket/key"}, "arn:aws:s3:::my-bucket/key", true},
+		{&ArnEqualsCondition{Arn: "arn:aws:s3:::my-bucket/key"}, "arn:aws:s3:::other-bucket/key", false},
+		{&ArnLikeCondition{Pattern: "arn:aws:s3:::my-bucket/*"}, "arn:aws:s3:::my-bucket/key", true},
+		{&ArnLikeCondition{Pattern: "arn:aws:s3:::my-bucket/*"}, "arn:aws:s3:::other-bucket/key", false},
+		{&ArnLikeCondition{Pattern: "arn:aws:s3:::my-bucket/*"}, "not-an-arn", false},
+	} {
+		assert.Equal(t, tc.fulfills, tc.c.Fulfills(tc.value, &Request{}), "case %d", k)
+	}
+}
+
+func TestAWSConditionsImplementReasoningCondition(t *testing.T) {
+	for k, tc := range []struct {
+		c     Condition
+		value interface{}
+	}{
+		{&StringNotEqualsCondition{Equals: "admin"}, "admin"},
+		{&StringEqualsIgnoreCaseCondition{Equals: "admin"}, "user"},
+		{&StringLikeCondition{Pattern: "myrn:*"}, "other"},
+		{&StringNotLikeCondition{Pattern: "myrn:*"}, "myrn:resource"},
+		{&BoolCondition{Value: true}, false},
+		{&IpAddressCondition{CIDR: []string{"127.0.0.1/32"}}, "0.0.0.0"},
+		{&NotIpAddressCondition{CIDR: []string{"127.0.0.1/32"}}, "127.0.0.1"},
+		{&ArnEqualsCondition{Arn: "arn:aws:s3:::my-bucket/key"}, "arn:aws:s3:::other-bucket/key"},
+		{&ArnLikeCondition{Pattern: "arn:aws:s3:::my-bucket/*"}, "not-an-arn"},
+	} {
+		reasoning, ok := tc.c.(ReasoningCondition)
+		if assert.True(t, ok, "case %d: %T does not implement ReasoningCondition", k, tc.c) {
+			assert.NotEmpty(t, reasoning.Reason(tc.value), "case %d", k)
+		}
+	}
+}
+
+func TestAWSConditionsRoundTripThroughConditions(t *testing.T) {
+	css := &Conditions{
+		"amount":    &NumericGreaterThanCondition{Value: 100},
+		"role":      &StringLikeCondition{Pattern: "admin*"},
+		"confirmed": &BoolCondition{Value: true},
+		"clientIP":  &IpAddressCondition{CIDR: []string{"127.0.0.1/32"}},
+		"resource":  &ArnEqualsCondition{Arn: "arn:aws:s3:::my-bucket/key"},
+	}
+
+	out, err := json.Marshal(css)
+	assert.Nil(t, err)
+
+	cs := Conditions{}
+	assert.Nil(t, cs.UnmarshalJSON(out))
+	assert.IsType(t, &NumericGreaterThanCondition{}, cs["amount"])
+	assert.IsType(t, &StringLikeCondition{}, cs["role"])
+	assert.IsType(t, &BoolCondition{}, cs["confirmed"])
+	assert.IsType(t, &IpAddressCondition{}, cs["clientIP"])
+	assert.IsType(t, &ArnEqualsCondition{}, cs["resource"])
+}
@@ -0,0 +1,24 @@
+package ladon
+
+import "fmt"
+
+// BoolCondition is fulfilled if the given value is a bool equal to Value.
+type BoolCondition struct {
+	Value bool `json:"value"`
+}
+
+// Fulfills returns true if value is a bool equal to c.Value.
+func (c *BoolCondition) Fulfills(value interface{}, _ *Request) bool {
+	b, ok := value.(bool)
+	return ok && b == c.Value
+}
+
+// GetName returns the condition's name.
+func (c *BoolCondition) GetName() string {
+	return "BoolCondition"
+}
+
+// Reason explains why value was not a bool equal to c.Value.
+func (c *BoolCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not a bool equal to %v", value, c.Value)
+}
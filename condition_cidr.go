@@ -0,0 +1,35 @@
+package ladon
+
+import (
+	"fmt"
+	"net"
+)
+
+// CIDRCondition is fulfilled if the given value is an IP address contained in the given CIDR.
+type CIDRCondition struct {
+	CIDR string `json:"cidr"`
+}
+
+// Fulfills returns true if value is an IP address within c.CIDR.
+func (c *CIDRCondition) Fulfills(value interface{}, _ *Request) bool {
+	ip := parseIP(value)
+	if ip == nil {
+		return false
+	}
+
+	_, network, err := net.ParseCIDR(c.CIDR)
+	if err != nil {
+		return false
+	}
+	return network.Contains(ip)
+}
+
+// GetName returns the condition's name.
+func (c *CIDRCondition) GetName() string {
+	return "CIDRCondition"
+}
+
+// Reason explains why value was not an IP address within c.CIDR.
+func (c *CIDRCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not an IP address within %s", value, c.CIDR)
+}
@@ -0,0 +1,102 @@
+package ladon
+
+import (
+	"fmt"
+	"time"
+)
+
+// toTime converts value to a time.Time. Strings are parsed as RFC3339; numbers (including
+// json.Number) are interpreted as Unix seconds.
+func toTime(value interface{}) (time.Time, bool) {
+	if s, ok := value.(string); ok {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+
+	if f, ok := toFloat64(value); ok {
+		return time.Unix(int64(f), 0), true
+	}
+
+	return time.Time{}, false
+}
+
+// DateEqualsCondition is fulfilled if the given value is a date equal to Value.
+type DateEqualsCondition struct {
+	// Value is an RFC3339 timestamp.
+	Value string `json:"value"`
+}
+
+// Fulfills returns true if value is a date equal to c.Value.
+func (c *DateEqualsCondition) Fulfills(value interface{}, _ *Request) bool {
+	want, ok := toTime(c.Value)
+	if !ok {
+		return false
+	}
+	got, ok := toTime(value)
+	return ok && got.Equal(want)
+}
+
+// GetName returns the condition's name.
+func (c *DateEqualsCondition) GetName() string {
+	return "DateEqualsCondition"
+}
+
+// Reason explains why value was not a date equal to c.Value.
+func (c *DateEqualsCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not a date equal to %s", value, c.Value)
+}
+
+// DateLessThanCondition is fulfilled if the given value is a date before Value.
+type DateLessThanCondition struct {
+	// Value is an RFC3339 timestamp.
+	Value string `json:"value"`
+}
+
+// Fulfills returns true if value is a date before c.Value.
+func (c *DateLessThanCondition) Fulfills(value interface{}, _ *Request) bool {
+	want, ok := toTime(c.Value)
+	if !ok {
+		return false
+	}
+	got, ok := toTime(value)
+	return ok && got.Before(want)
+}
+
+// GetName returns the condition's name.
+func (c *DateLessThanCondition) GetName() string {
+	return "DateLessThanCondition"
+}
+
+// Reason explains why value was not a date before c.Value.
+func (c *DateLessThanCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not a date before %s", value, c.Value)
+}
+
+// DateGreaterThanCondition is fulfilled if the given value is a date after Value.
+type DateGreaterThanCondition struct {
+	// Value is an RFC3339 timestamp.
+	Value string `json:"value"`
+}
+
+// Fulfills returns true if value is a date after c.Value.
+func (c *DateGreaterThanCondition) Fulfills(value interface{}, _ *Request) bool {
+	want, ok := toTime(c.Value)
+	if !ok {
+		return false
+	}
+	got, ok := toTime(value)
+	return ok && got.After(want)
+}
+
+// GetName returns the condition's name.
+func (c *DateGreaterThanCondition) GetName() string {
+	return "DateGreaterThanCondition"
+}
+
+// Reason explains why value was not a date after c.Value.
+func (c *DateGreaterThanCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not a date after %s", value, c.Value)
+}
@@ -12,3 +12,16 @@ func (c *DefinedCondition) Fulfills(value interface{}, _ *Request) bool {
 func (c *DefinedCondition) GetName() string {
 	return "DefinedCondition"
 }
+
+// NotDefinedCondition is a condition which is fulfilled if a field is not defined.
+type NotDefinedCondition struct{}
+
+// Fulfills returns true if the given value is not defined.
+func (c *NotDefinedCondition) Fulfills(value interface{}, _ *Request) bool {
+	return value == nil
+}
+
+// GetName returns the condition's name.
+func (c *NotDefinedCondition) GetName() string {
+	return "NotDefinedCondition"
+}
@@ -0,0 +1,78 @@
+package ladon
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// IpAddressCondition is fulfilled if the given value is an IPv4 or IPv6 address contained
+// in any of CIDR. It is a superset of CIDRCondition, which only accepts a single CIDR.
+type IpAddressCondition struct {
+	CIDR []string `json:"cidr"`
+}
+
+// Fulfills returns true if value is an IP address within one of c.CIDR.
+func (c *IpAddressCondition) Fulfills(value interface{}, _ *Request) bool {
+	ip := parseIP(value)
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range c.CIDR {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetName returns the condition's name.
+func (c *IpAddressCondition) GetName() string {
+	return "IpAddressCondition"
+}
+
+// Reason explains why value was not an IP address within any of c.CIDR.
+func (c *IpAddressCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not an IP address within any of %s", value, strings.Join(c.CIDR, ", "))
+}
+
+// NotIpAddressCondition is fulfilled if the given value is an IPv4 or IPv6 address not
+// contained in any of CIDR.
+type NotIpAddressCondition struct {
+	CIDR []string `json:"cidr"`
+}
+
+// Fulfills returns true if value is an IP address within none of c.CIDR.
+func (c *NotIpAddressCondition) Fulfills(value interface{}, r *Request) bool {
+	positive := &IpAddressCondition{CIDR: c.CIDR}
+	ip := parseIP(value)
+	return ip != nil && !positive.Fulfills(value, r)
+}
+
+// GetName returns the condition's name.
+func (c *NotIpAddressCondition) GetName() string {
+	return "NotIpAddressCondition"
+}
+
+// Reason explains why value was an IP address within one of c.CIDR, or not an IP address
+// at all.
+func (c *NotIpAddressCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is an IP address within one of %s, or not an IP address", value, strings.Join(c.CIDR, ", "))
+}
+
+// parseIP converts value to a net.IP, accepting both a string and a net.IP directly.
+func parseIP(value interface{}) net.IP {
+	switch v := value.(type) {
+	case string:
+		return net.ParseIP(v)
+	case net.IP:
+		return v
+	default:
+		return nil
+	}
+}
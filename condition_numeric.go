@@ -0,0 +1,156 @@
+package ladon
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// toFloat64 converts value to a float64 if it is an int, float or json.Number, as produced
+// by decoding a Request.Context from JSON.
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// NumericEqualsCondition is fulfilled if the given value is numerically equal to Value.
+type NumericEqualsCondition struct {
+	Value float64 `json:"value"`
+}
+
+// Fulfills returns true if value is numerically equal to c.Value.
+func (c *NumericEqualsCondition) Fulfills(value interface{}, _ *Request) bool {
+	v, ok := toFloat64(value)
+	return ok && v == c.Value
+}
+
+// GetName returns the condition's name.
+func (c *NumericEqualsCondition) GetName() string {
+	return "NumericEqualsCondition"
+}
+
+// Reason explains why value was not numerically equal to c.Value.
+func (c *NumericEqualsCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v does not numerically equal %v", value, c.Value)
+}
+
+// NumericNotEqualsCondition is fulfilled if the given value is numerically different from Value.
+type NumericNotEqualsCondition struct {
+	Value float64 `json:"value"`
+}
+
+// Fulfills returns true if value is numerically different from c.Value.
+func (c *NumericNotEqualsCondition) Fulfills(value interface{}, _ *Request) bool {
+	v, ok := toFloat64(value)
+	return ok && v != c.Value
+}
+
+// GetName returns the condition's name.
+func (c *NumericNotEqualsCondition) GetName() string {
+	return "NumericNotEqualsCondition"
+}
+
+// Reason explains why value was numerically equal to c.Value.
+func (c *NumericNotEqualsCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v numerically equals %v", value, c.Value)
+}
+
+// NumericLessThanCondition is fulfilled if the given value is numerically less than Value.
+type NumericLessThanCondition struct {
+	Value float64 `json:"value"`
+}
+
+// Fulfills returns true if value is numerically less than c.Value.
+func (c *NumericLessThanCondition) Fulfills(value interface{}, _ *Request) bool {
+	v, ok := toFloat64(value)
+	return ok && v < c.Value
+}
+
+// GetName returns the condition's name.
+func (c *NumericLessThanCondition) GetName() string {
+	return "NumericLessThanCondition"
+}
+
+// Reason explains why value was not numerically less than c.Value.
+func (c *NumericLessThanCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not numerically less than %v", value, c.Value)
+}
+
+// NumericLessThanEqualsCondition is fulfilled if the given value is numerically less than
+// or equal to Value.
+type NumericLessThanEqualsCondition struct {
+	Value float64 `json:"value"`
+}
+
+// Fulfills returns true if value is numerically less than or equal to c.Value.
+func (c *NumericLessThanEqualsCondition) Fulfills(value interface{}, _ *Request) bool {
+	v, ok := toFloat64(value)
+	return ok && v <= c.Value
+}
+
+// GetName returns the condition's name.
+func (c *NumericLessThanEqualsCondition) GetName() string {
+	return "NumericLessThanEqualsCondition"
+}
+
+// Reason explains why value was not numerically less than or equal to c.Value.
+func (c *NumericLessThanEqualsCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not numerically less than or equal to %v", value, c.Value)
+}
+
+// NumericGreaterThanCondition is fulfilled if the given value is numerically greater than Value.
+type NumericGreaterThanCondition struct {
+	Value float64 `json:"value"`
+}
+
+// Fulfills returns true if value is numerically greater than c.Value.
+func (c *NumericGreaterThanCondition) Fulfills(value interface{}, _ *Request) bool {
+	v, ok := toFloat64(value)
+	return ok && v > c.Value
+}
+
+// GetName returns the condition's name.
+func (c *NumericGreaterThanCondition) GetName() string {
+	return "NumericGreaterThanCondition"
+}
+
+// Reason explains why value was not numerically greater than c.Value.
+func (c *NumericGreaterThanCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not numerically greater than %v", value, c.Value)
+}
+
+// NumericGreaterThanEqualsCondition is fulfilled if the given value is numerically greater
+// than or equal to Value.
+type NumericGreaterThanEqualsCondition struct {
+	Value float64 `json:"value"`
+}
+
+// Fulfills returns true if value is numerically greater than or equal to c.Value.
+func (c *NumericGreaterThanEqualsCondition) Fulfills(value interface{}, _ *Request) bool {
+	v, ok := toFloat64(value)
+	return ok && v >= c.Value
+}
+
+// GetName returns the condition's name.
+func (c *NumericGreaterThanEqualsCondition) GetName() string {
+	return "NumericGreaterThanEqualsCondition"
+}
+
+// Reason explains why value was not numerically greater than or equal to c.Value.
+func (c *NumericGreaterThanEqualsCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v is not numerically greater than or equal to %v", value, c.Value)
+}
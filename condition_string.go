@@ -0,0 +1,115 @@
+package ladon
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// StringNotEqualsCondition is fulfilled if the given value is a string different from Equals.
+type StringNotEqualsCondition struct {
+	Equals string `json:"equals"`
+}
+
+// Fulfills returns true if value is a string different from c.Equals.
+func (c *StringNotEqualsCondition) Fulfills(value interface{}, _ *Request) bool {
+	s, ok := value.(string)
+	return ok && s != c.Equals
+}
+
+// GetName returns the condition's name.
+func (c *StringNotEqualsCondition) GetName() string {
+	return "StringNotEqualsCondition"
+}
+
+// Reason explains why value equaled c.Equals.
+func (c *StringNotEqualsCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v equals %q", value, c.Equals)
+}
+
+// StringEqualsIgnoreCaseCondition is fulfilled if the given value is a string identical to
+// Equals, ignoring case.
+type StringEqualsIgnoreCaseCondition struct {
+	Equals string `json:"equals"`
+}
+
+// Fulfills returns true if value equals c.Equals, ignoring case.
+func (c *StringEqualsIgnoreCaseCondition) Fulfills(value interface{}, _ *Request) bool {
+	s, ok := value.(string)
+	return ok && strings.EqualFold(s, c.Equals)
+}
+
+// GetName returns the condition's name.
+func (c *StringEqualsIgnoreCaseCondition) GetName() string {
+	return "StringEqualsIgnoreCaseCondition"
+}
+
+// Reason explains why value did not equal c.Equals, ignoring case.
+func (c *StringEqualsIgnoreCaseCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v does not equal %q (ignoring case)", value, c.Equals)
+}
+
+// StringLikeCondition is fulfilled if the given value is a string matching Pattern, a glob
+// that supports '*' (any number of characters) and '?' (a single character).
+type StringLikeCondition struct {
+	Pattern string `json:"pattern"`
+}
+
+// Fulfills returns true if value matches c.Pattern.
+func (c *StringLikeCondition) Fulfills(value interface{}, _ *Request) bool {
+	s, ok := value.(string)
+	return ok && globMatch(c.Pattern, s)
+}
+
+// GetName returns the condition's name.
+func (c *StringLikeCondition) GetName() string {
+	return "StringLikeCondition"
+}
+
+// Reason explains why value did not match c.Pattern.
+func (c *StringLikeCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v does not match pattern %q", value, c.Pattern)
+}
+
+// StringNotLikeCondition is fulfilled if the given value is a string that does not match
+// Pattern, a glob that supports '*' and '?'.
+type StringNotLikeCondition struct {
+	Pattern string `json:"pattern"`
+}
+
+// Fulfills returns true if value does not match c.Pattern.
+func (c *StringNotLikeCondition) Fulfills(value interface{}, _ *Request) bool {
+	s, ok := value.(string)
+	return ok && !globMatch(c.Pattern, s)
+}
+
+// GetName returns the condition's name.
+func (c *StringNotLikeCondition) GetName() string {
+	return "StringNotLikeCondition"
+}
+
+// Reason explains why value matched c.Pattern.
+func (c *StringNotLikeCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v matches pattern %q", value, c.Pattern)
+}
+
+// globMatch reports whether s matches the glob pattern, where '*' matches any run of
+// characters and '?' matches exactly one.
+func globMatch(pattern, s string) bool {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+
+	matched, err := regexp.MatchString(b.String(), s)
+	return err == nil && matched
+}
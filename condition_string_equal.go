@@ -0,0 +1,24 @@
+package ladon
+
+import "fmt"
+
+// StringEqualCondition is fulfilled if the given value is a string identical to Equals.
+type StringEqualCondition struct {
+	Equals string `json:"equals"`
+}
+
+// Fulfills returns true if value equals c.Equals.
+func (c *StringEqualCondition) Fulfills(value interface{}, _ *Request) bool {
+	s, ok := value.(string)
+	return ok && s == c.Equals
+}
+
+// GetName returns the condition's name.
+func (c *StringEqualCondition) GetName() string {
+	return "StringEqualCondition"
+}
+
+// Reason explains why value did not equal c.Equals.
+func (c *StringEqualCondition) Reason(value interface{}) string {
+	return fmt.Sprintf("value %v does not equal %q", value, c.Equals)
+}
@@ -0,0 +1,14 @@
+package ladon
+
+// EqualsSubjectCondition is fulfilled if the given value matches the request's subject.
+type EqualsSubjectCondition struct{}
+
+// Fulfills returns true if value equals the request's subject.
+func (c *EqualsSubjectCondition) Fulfills(value interface{}, r *Request) bool {
+	return r.Subject == value
+}
+
+// GetName returns the condition's name.
+func (c *EqualsSubjectCondition) GetName() string {
+	return "EqualsSubjectCondition"
+}
@@ -0,0 +1,53 @@
+package ladon
+
+// Decision is the structured result of evaluating a Request against every policy returned
+// by Manager.FindRequestCandidates.
+type Decision struct {
+	// Allowed is the final result, after applying deny-overrides-allow-overrides-implicit-deny
+	// precedence across Results.
+	Allowed bool
+
+	// Request is the request that was evaluated.
+	Request *Request
+
+	// Results records, for every candidate policy, whether it matched and why.
+	Results []PolicyResult
+}
+
+// PolicyResult records whether a single policy matched a Request, and why.
+type PolicyResult struct {
+	Policy Policy
+
+	// Matched is true if the policy's subjects, actions, resources and conditions all
+	// applied to the request.
+	Matched bool
+
+	// Reason explains why Matched is false; empty when Matched is true.
+	Reason string
+
+	// Conditions records the outcome of evaluating each of the policy's conditions. It is
+	// only populated once subjects, actions and resources have matched.
+	Conditions []ConditionResult
+}
+
+// ConditionResult records the outcome of evaluating a single condition against the
+// request's context.
+type ConditionResult struct {
+	// Key is the context key the condition was registered under.
+	Key string
+
+	// Fulfilled is true if the condition matched the request's context.
+	Fulfilled bool
+
+	// Reason explains why Fulfilled is false. It is empty unless the condition implements
+	// ReasoningCondition.
+	Reason string
+}
+
+// ReasoningCondition may optionally be implemented by a Condition to explain, in prose, why
+// it did not fulfill the given value. It takes the same value Fulfills was called with,
+// rather than caching it on the Condition, because Conditions are shared across concurrent
+// requests.
+type ReasoningCondition interface {
+	Reason(value interface{}) string
+}
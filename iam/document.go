@@ -0,0 +1,92 @@
+// Package iam converts between AWS-style IAM policy documents and ladon's
+// native DefaultPolicy/Conditions, so that existing IAM policies can be
+// adopted by a ladon Manager without hand-translating them into ladon's
+// JSON format.
+package iam
+
+import "encoding/json"
+
+// Document is an AWS IAM policy document.
+type Document struct {
+	Version   string      `json:"Version,omitempty"`
+	Statement []Statement `json:"Statement"`
+}
+
+// Statement is a single IAM policy statement.
+type Statement struct {
+	Sid    string `json:"Sid,omitempty"`
+	Effect string `json:"Effect"`
+
+	Principal    *Principal `json:"Principal,omitempty"`
+	NotPrincipal *Principal `json:"NotPrincipal,omitempty"`
+
+	Action    StringSet `json:"Action,omitempty"`
+	NotAction StringSet `json:"NotAction,omitempty"`
+
+	Resource    StringSet `json:"Resource,omitempty"`
+	NotResource StringSet `json:"NotResource,omitempty"`
+
+	Condition map[string]map[string]StringSet `json:"Condition,omitempty"`
+}
+
+// Principal is an IAM principal block. AWS allows it to be the literal
+// string "*" or a map of principal type (e.g. "AWS") to one or more ARNs;
+// both forms are accepted here.
+type Principal struct {
+	Wildcard bool
+	Entries  map[string]StringSet
+}
+
+// UnmarshalJSON accepts either the string "*" or a map of principal type to
+// ARN(s).
+func (p *Principal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		p.Wildcard = wildcard == "*"
+		return nil
+	}
+
+	entries := map[string]StringSet{}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	p.Entries = entries
+	return nil
+}
+
+// MarshalJSON renders the wildcard form when set, otherwise the map form.
+func (p Principal) MarshalJSON() ([]byte, error) {
+	if p.Wildcard {
+		return json.Marshal("*")
+	}
+	return json.Marshal(p.Entries)
+}
+
+// StringSet is an IAM field that AWS allows to be encoded as either a single
+// string or an array of strings.
+type StringSet []string
+
+// UnmarshalJSON accepts a bare string or an array of strings.
+func (s *StringSet) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = StringSet{single}
+		return nil
+	}
+
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*s = StringSet(many)
+	return nil
+}
+
+// MarshalJSON renders a single-element set as a bare string, matching the
+// shape AWS itself produces, and anything else as an array.
+func (s StringSet) MarshalJSON() ([]byte, error) {
+	if len(s) == 1 {
+		return json.Marshal(s[0])
+	}
+	return json.Marshal([]string(s))
+}
@@ -0,0 +1,172 @@
+package iam
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/olivierdeckers/ladon"
+)
+
+// Export marshals policies into a single IAM policy document. Subject, resource and action
+// patterns written as ladon regexes are translated to AWS globs; a pattern that can't be
+// expressed as a glob (alternation, character classes, ...) makes Export fail rather than
+// silently drop it, since IAM has no way to evaluate a ladon regex at request time.
+// NotSubjects, NotActions and NotResources are exported the same way, under NotPrincipal,
+// NotAction and NotResource. A policy's Conditions are translated to the matching IAM
+// condition operator; a condition with no IAM equivalent (such as EqualsSubjectCondition)
+// makes Export fail for the same reason.
+func Export(policies []ladon.Policy) (*Document, error) {
+	doc := &Document{
+		Version:   "2012-10-17",
+		Statement: make([]Statement, 0, len(policies)),
+	}
+
+	for _, policy := range policies {
+		statement, err := exportPolicy(policy)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %s", policy.GetID(), err)
+		}
+		doc.Statement = append(doc.Statement, statement)
+	}
+
+	return doc, nil
+}
+
+func exportPolicy(policy ladon.Policy) (Statement, error) {
+	statement := Statement{Sid: policy.GetID()}
+
+	if policy.AllowAccess() {
+		statement.Effect = "Allow"
+	} else {
+		statement.Effect = "Deny"
+	}
+
+	subjects, err := exportPatterns(policy.GetSubjects())
+	if err != nil {
+		return Statement{}, fmt.Errorf("subjects: %s", err)
+	}
+	statement.Principal = &Principal{Entries: map[string]StringSet{"AWS": subjects}}
+
+	if notSubjects := policy.GetNotSubjects(); len(notSubjects) > 0 {
+		notSubjectGlobs, err := exportPatterns(notSubjects)
+		if err != nil {
+			return Statement{}, fmt.Errorf("notSubjects: %s", err)
+		}
+		statement.NotPrincipal = &Principal{Entries: map[string]StringSet{"AWS": notSubjectGlobs}}
+	}
+
+	actions, err := exportPatterns(policy.GetActions())
+	if err != nil {
+		return Statement{}, fmt.Errorf("actions: %s", err)
+	}
+	statement.Action = actions
+
+	if notActions := policy.GetNotActions(); len(notActions) > 0 {
+		notActionGlobs, err := exportPatterns(notActions)
+		if err != nil {
+			return Statement{}, fmt.Errorf("notActions: %s", err)
+		}
+		statement.NotAction = notActionGlobs
+	}
+
+	resources, err := exportPatterns(policy.GetResources())
+	if err != nil {
+		return Statement{}, fmt.Errorf("resources: %s", err)
+	}
+	statement.Resource = resources
+
+	if notResources := policy.GetNotResources(); len(notResources) > 0 {
+		notResourceGlobs, err := exportPatterns(notResources)
+		if err != nil {
+			return Statement{}, fmt.Errorf("notResources: %s", err)
+		}
+		statement.NotResource = notResourceGlobs
+	}
+
+	condition := map[string]map[string]StringSet{}
+	for key, c := range policy.GetConditions() {
+		operator, value, err := exportCondition(c)
+		if err != nil {
+			return Statement{}, fmt.Errorf("condition %s: %s", key, err)
+		}
+		if condition[operator] == nil {
+			condition[operator] = map[string]StringSet{}
+		}
+		condition[operator][key] = value
+	}
+
+	if len(condition) > 0 {
+		statement.Condition = condition
+	}
+
+	return statement, nil
+}
+
+// exportCondition translates a single ladon.Condition into the IAM condition operator and
+// value(s) that reconstruct it, the inverse of importCondition.
+func exportCondition(c ladon.Condition) (operator string, values StringSet, err error) {
+	switch cond := c.(type) {
+	case *ladon.CIDRCondition:
+		return "IpAddress", StringSet{cond.CIDR}, nil
+	case *ladon.IpAddressCondition:
+		return "IpAddress", StringSet(cond.CIDR), nil
+	case *ladon.NotIpAddressCondition:
+		return "NotIpAddress", StringSet(cond.CIDR), nil
+	case *ladon.StringEqualCondition:
+		return "StringEquals", StringSet{cond.Equals}, nil
+	case *ladon.StringNotEqualsCondition:
+		return "StringNotEquals", StringSet{cond.Equals}, nil
+	case *ladon.StringEqualsIgnoreCaseCondition:
+		return "StringEqualsIgnoreCase", StringSet{cond.Equals}, nil
+	case *ladon.StringLikeCondition:
+		return "StringLike", StringSet{cond.Pattern}, nil
+	case *ladon.StringNotLikeCondition:
+		return "StringNotLike", StringSet{cond.Pattern}, nil
+	case *ladon.NumericEqualsCondition:
+		return "NumericEquals", StringSet{formatFloat(cond.Value)}, nil
+	case *ladon.NumericNotEqualsCondition:
+		return "NumericNotEquals", StringSet{formatFloat(cond.Value)}, nil
+	case *ladon.NumericLessThanCondition:
+		return "NumericLessThan", StringSet{formatFloat(cond.Value)}, nil
+	case *ladon.NumericLessThanEqualsCondition:
+		return "NumericLessThanEquals", StringSet{formatFloat(cond.Value)}, nil
+	case *ladon.NumericGreaterThanCondition:
+		return "NumericGreaterThan", StringSet{formatFloat(cond.Value)}, nil
+	case *ladon.NumericGreaterThanEqualsCondition:
+		return "NumericGreaterThanEquals", StringSet{formatFloat(cond.Value)}, nil
+	case *ladon.DateEqualsCondition:
+		return "DateEquals", StringSet{cond.Value}, nil
+	case *ladon.DateLessThanCondition:
+		return "DateLessThan", StringSet{cond.Value}, nil
+	case *ladon.DateGreaterThanCondition:
+		return "DateGreaterThan", StringSet{cond.Value}, nil
+	case *ladon.BoolCondition:
+		return "Bool", StringSet{strconv.FormatBool(cond.Value)}, nil
+	case *ladon.ArnEqualsCondition:
+		return "ArnEquals", StringSet{cond.Arn}, nil
+	case *ladon.ArnLikeCondition:
+		return "ArnLike", StringSet{cond.Pattern}, nil
+	default:
+		return "", nil, fmt.Errorf("condition type %s has no IAM equivalent", c.GetName())
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// exportPatterns converts ladon patterns to AWS globs, failing if any pattern can't be
+// represented as one.
+func exportPatterns(patterns []string) (StringSet, error) {
+	globs := make(StringSet, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		glob, ok := toGlob(pattern)
+		if !ok {
+			return nil, fmt.Errorf("pattern %q has no AWS glob equivalent", pattern)
+		}
+		globs = append(globs, glob)
+	}
+
+	return globs, nil
+}
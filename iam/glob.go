@@ -0,0 +1,49 @@
+package iam
+
+import "regexp"
+
+// ladonPattern matches a ladon regular expression embedded between angle
+// brackets, e.g. "myrn:some.domain.com:resource:<.+>".
+var ladonPattern = regexp.MustCompile(`<([^>]*)>`)
+
+// simpleGlob recognises the handful of ladon regex fragments that translate
+// to an AWS glob ("*" / "?"): "*" for ".*" or ".+", "?" for ".", and literal
+// text for anything without meta characters. The "*" emitted for ".+" also
+// matches the empty string, which ".+" does not. It returns false
+// when the fragment uses something a glob can't express, such as
+// alternation ("a|b") or character classes.
+var metaCharacters = regexp.MustCompile(`[.+*?()\[\]{}^$|\\]`)
+
+func simpleGlob(fragment string) (string, bool) {
+	switch fragment {
+	case ".*", ".+":
+		return "*", true
+	case ".":
+		return "?", true
+	case "":
+		return "", true
+	}
+	if metaCharacters.MatchString(fragment) {
+		return "", false
+	}
+	return fragment, true
+}
+
+// toGlob converts a ladon pattern to an AWS-style glob wherever possible. It
+// reports whether every embedded regex fragment could be represented as a
+// glob; when false, the pattern has no IAM equivalent and Export must fail
+// rather than emit a glob IAM would evaluate differently from the original
+// pattern.
+func toGlob(pattern string) (glob string, ok bool) {
+	ok = true
+	glob = ladonPattern.ReplaceAllStringFunc(pattern, func(match string) string {
+		fragment := match[1 : len(match)-1]
+		replacement, fragmentOK := simpleGlob(fragment)
+		if !fragmentOK {
+			ok = false
+			return match
+		}
+		return replacement
+	})
+	return glob, ok
+}
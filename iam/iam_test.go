@@ -0,0 +1,263 @@
+package iam
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/olivierdeckers/ladon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImport(t *testing.T) {
+	var doc Document
+	require.Nil(t, json.Unmarshal([]byte(`{
+		"Version": "2012-10-17",
+		"Statement": [{
+			"Sid": "AllowGet",
+			"Effect": "Allow",
+			"Principal": {"AWS": "max"},
+			"Action": ["get", "list"],
+			"Resource": "myrn:some.domain.com:resource:123",
+			"Condition": {
+				"IpAddress": {"clientIP": "127.0.0.1/32"}
+			}
+		}]
+	}`), &doc))
+
+	policies, err := Import(&doc)
+	require.Nil(t, err)
+	require.Len(t, policies, 1)
+
+	p := policies[0]
+	assert.Equal(t, "AllowGet", p.GetID())
+	assert.Equal(t, ladon.AllowAccess, p.GetEffect())
+	assert.Equal(t, []string{"max"}, p.GetSubjects())
+	assert.Equal(t, []string{"get", "list"}, p.GetActions())
+	assert.Equal(t, []string{"myrn:some.domain.com:resource:123"}, p.GetResources())
+	assert.IsType(t, &ladon.CIDRCondition{}, p.GetConditions()["clientIP"])
+}
+
+func TestImportAcceptsFullOperatorSet(t *testing.T) {
+	var doc Document
+	require.Nil(t, json.Unmarshal([]byte(`{
+		"Statement": [{
+			"Sid": "FullOperatorSet",
+			"Effect": "Allow",
+			"Principal": {"AWS": "max"},
+			"Action": "get",
+			"Resource": "myrn:some.domain.com:resource:123",
+			"Condition": {
+				"IpAddress": {"clientIPs": ["127.0.0.1/32", "10.0.0.0/8"]},
+				"NotIpAddress": {"blockedIPs": "192.0.2.0/24"},
+				"StringNotEquals": {"env": "prod"},
+				"StringEqualsIgnoreCase": {"role": "Admin"},
+				"StringNotLike": {"path": "/admin/*"},
+				"NumericLessThanEquals": {"age": "30"},
+				"DateGreaterThan": {"signedUpAt": "2020-01-01T00:00:00Z"},
+				"Bool": {"mfa": "true"},
+				"ArnEquals": {"caller": "arn:aws:iam::123456789012:role/Example"}
+			}
+		}]
+	}`), &doc))
+
+	policies, err := Import(&doc)
+	require.Nil(t, err)
+	require.Len(t, policies, 1)
+
+	conditions := policies[0].GetConditions()
+	assert.IsType(t, &ladon.IpAddressCondition{}, conditions["clientIPs"])
+	assert.IsType(t, &ladon.NotIpAddressCondition{}, conditions["blockedIPs"])
+	assert.IsType(t, &ladon.StringNotEqualsCondition{}, conditions["env"])
+	assert.IsType(t, &ladon.StringEqualsIgnoreCaseCondition{}, conditions["role"])
+	assert.IsType(t, &ladon.StringNotLikeCondition{}, conditions["path"])
+	assert.IsType(t, &ladon.NumericLessThanEqualsCondition{}, conditions["age"])
+	assert.IsType(t, &ladon.DateGreaterThanCondition{}, conditions["signedUpAt"])
+	assert.IsType(t, &ladon.BoolCondition{}, conditions["mfa"])
+	assert.IsType(t, &ladon.ArnEqualsCondition{}, conditions["caller"])
+}
+
+func TestImportAcceptsInvertedFields(t *testing.T) {
+	var doc Document
+	require.Nil(t, json.Unmarshal([]byte(`{
+		"Statement": [{
+			"Effect": "Deny",
+			"NotPrincipal": {"AWS": "max"},
+			"Action": "broadcast",
+			"NotAction": ["peek"],
+			"Resource": "<.*>",
+			"NotResource": ["myrn:public:<.*>"]
+		}]
+	}`), &doc))
+
+	policies, err := Import(&doc)
+	require.Nil(t, err)
+	require.Len(t, policies, 1)
+
+	p := policies[0]
+	assert.Equal(t, []string{"max"}, p.GetNotSubjects())
+	assert.Equal(t, []string{"peek"}, p.GetNotActions())
+	assert.Equal(t, []string{"myrn:public:<.*>"}, p.GetNotResources())
+}
+
+func TestExportRejectsPatternWithNoGlobEquivalent(t *testing.T) {
+	policies := []ladon.Policy{
+		&ladon.DefaultPolicy{
+			ID:        "1",
+			Subjects:  []string{"max"},
+			Actions:   []string{"<create|delete>"},
+			Resources: []string{"<.*>"},
+			Effect:    ladon.AllowAccess,
+		},
+	}
+
+	_, err := Export(policies)
+	assert.NotNil(t, err)
+}
+
+func TestExportTranslatesWildcards(t *testing.T) {
+	policies := []ladon.Policy{
+		&ladon.DefaultPolicy{
+			ID:        "3",
+			Subjects:  []string{"max"},
+			Actions:   []string{"broadcast"},
+			Resources: []string{"<.*>"},
+			Effect:    ladon.DenyAccess,
+		},
+	}
+
+	doc, err := Export(policies)
+	require.Nil(t, err)
+	require.Len(t, doc.Statement, 1)
+
+	statement := doc.Statement[0]
+	assert.Equal(t, "Deny", statement.Effect)
+	assert.Equal(t, StringSet{"*"}, statement.Resource)
+	assert.Nil(t, statement.Condition)
+}
+
+func TestExportConvertsRepresentablePatterns(t *testing.T) {
+	policies := []ladon.Policy{
+		&ladon.DefaultPolicy{
+			ID:        "1",
+			Subjects:  []string{"max", "peter"},
+			Actions:   []string{"get"},
+			Resources: []string{"myrn:something:foo:<.+>"},
+			Effect:    ladon.AllowAccess,
+		},
+	}
+
+	doc, err := Export(policies)
+	require.Nil(t, err)
+
+	statement := doc.Statement[0]
+	assert.Nil(t, statement.Condition)
+	assert.Equal(t, StringSet{"myrn:something:foo:*"}, statement.Resource)
+	assert.Equal(t, &Principal{Entries: map[string]StringSet{"AWS": {"max", "peter"}}}, statement.Principal)
+}
+
+func TestExportRejectsAlternationInAnyField(t *testing.T) {
+	base := ladon.DefaultPolicy{
+		ID:        "1",
+		Subjects:  []string{"max"},
+		Actions:   []string{"get"},
+		Resources: []string{"<.*>"},
+		Effect:    ladon.AllowAccess,
+	}
+
+	withSubjects := base
+	withSubjects.Subjects = []string{"<zac|ken>"}
+	_, err := Export([]ladon.Policy{&withSubjects})
+	assert.NotNil(t, err)
+
+	withActions := base
+	withActions.Actions = []string{"<create|delete>"}
+	_, err = Export([]ladon.Policy{&withActions})
+	assert.NotNil(t, err)
+}
+
+func TestExportIncludesConditions(t *testing.T) {
+	policies := []ladon.Policy{
+		&ladon.DefaultPolicy{
+			ID:        "1",
+			Subjects:  []string{"max"},
+			Actions:   []string{"get"},
+			Resources: []string{"myrn:some.domain.com:resource:123"},
+			Effect:    ladon.AllowAccess,
+			Conditions: ladon.Conditions{
+				"clientIP": &ladon.CIDRCondition{CIDR: "127.0.0.1/32"},
+			},
+		},
+	}
+
+	doc, err := Export(policies)
+	require.Nil(t, err)
+
+	statement := doc.Statement[0]
+	require.NotNil(t, statement.Condition)
+	assert.Equal(t, StringSet{"127.0.0.1/32"}, statement.Condition["IpAddress"]["clientIP"])
+
+	imported, err := Import(doc)
+	require.Nil(t, err)
+	assert.Equal(t, &ladon.CIDRCondition{CIDR: "127.0.0.1/32"}, imported[0].GetConditions()["clientIP"])
+}
+
+func TestExportRejectsConditionWithNoIAMEquivalent(t *testing.T) {
+	policies := []ladon.Policy{
+		&ladon.DefaultPolicy{
+			ID:        "1",
+			Subjects:  []string{"max"},
+			Actions:   []string{"get"},
+			Resources: []string{"myrn:some.domain.com:resource:123"},
+			Effect:    ladon.AllowAccess,
+			Conditions: ladon.Conditions{
+				"owner": &ladon.EqualsSubjectCondition{},
+			},
+		},
+	}
+
+	_, err := Export(policies)
+	assert.NotNil(t, err)
+}
+
+func TestExportIncludesNotFields(t *testing.T) {
+	policies := []ladon.Policy{
+		&ladon.DefaultPolicy{
+			ID:           "7",
+			Subjects:     []string{"ken"},
+			Actions:      []string{"broadcast"},
+			Resources:    []string{"<.*>"},
+			NotResources: []string{"myrn:public:<.*>"},
+			Effect:       ladon.DenyAccess,
+		},
+	}
+
+	doc, err := Export(policies)
+	require.Nil(t, err)
+
+	statement := doc.Statement[0]
+	assert.Equal(t, "Deny", statement.Effect)
+	assert.Equal(t, StringSet{"*"}, statement.Resource)
+	assert.Equal(t, StringSet{"myrn:public:*"}, statement.NotResource)
+}
+
+func TestNotFieldsRoundTripThroughImport(t *testing.T) {
+	policies := []ladon.Policy{
+		&ladon.DefaultPolicy{
+			ID:           "7",
+			Subjects:     []string{"ken"},
+			Actions:      []string{"broadcast"},
+			Resources:    []string{"<.*>"},
+			NotResources: []string{"myrn:public:<.*>"},
+			Effect:       ladon.DenyAccess,
+		},
+	}
+
+	doc, err := Export(policies)
+	require.Nil(t, err)
+
+	imported, err := Import(doc)
+	require.Nil(t, err)
+	require.Len(t, imported, 1)
+	assert.Equal(t, []string{"myrn:public:*"}, imported[0].GetNotResources())
+}
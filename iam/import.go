@@ -0,0 +1,251 @@
+package iam
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/olivierdeckers/ladon"
+)
+
+// Import converts an IAM policy document into one DefaultPolicy per statement.
+// NotPrincipal, NotAction and NotResource become NotSubjects, NotActions and NotResources
+// on the resulting policy, the inverse of Export's field mapping. Principal, Action and
+// Resource values are copied as written: AWS globs in them are not translated back into
+// ladon patterns, except that a missing or "*" Principal becomes "<.*>".
+func Import(doc *Document) ([]*ladon.DefaultPolicy, error) {
+	policies := make([]*ladon.DefaultPolicy, 0, len(doc.Statement))
+	for i, statement := range doc.Statement {
+		policy, err := importStatement(statement)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d (%s): %s", i, statement.Sid, err)
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// ImportToManager imports doc and creates the resulting policies in manager.
+func ImportToManager(doc *Document, manager ladon.Manager) error {
+	policies, err := Import(doc)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if err := manager.Create(policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func importStatement(statement Statement) (*ladon.DefaultPolicy, error) {
+	effect, err := importEffect(statement.Effect)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, err := importConditions(statement.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	var notSubjects []string
+	if statement.NotPrincipal != nil {
+		notSubjects = importPrincipal(statement.NotPrincipal)
+	}
+
+	return &ladon.DefaultPolicy{
+		ID:           statement.Sid,
+		Subjects:     importPrincipal(statement.Principal),
+		Actions:      []string(statement.Action),
+		Resources:    []string(statement.Resource),
+		NotSubjects:  notSubjects,
+		NotActions:   []string(statement.NotAction),
+		NotResources: []string(statement.NotResource),
+		Effect:       effect,
+		Conditions:   conditions,
+	}, nil
+}
+
+func importEffect(effect string) (string, error) {
+	switch effect {
+	case "Allow":
+		return ladon.AllowAccess, nil
+	case "Deny":
+		return ladon.DenyAccess, nil
+	default:
+		return "", fmt.Errorf("unknown Effect %q", effect)
+	}
+}
+
+func importPrincipal(principal *Principal) []string {
+	if principal == nil || principal.Wildcard {
+		return []string{"<.*>"}
+	}
+
+	subjects := make([]string, 0, len(principal.Entries))
+	for _, arns := range principal.Entries {
+		subjects = append(subjects, arns...)
+	}
+	return subjects
+}
+
+func importConditions(condition map[string]map[string]StringSet) (ladon.Conditions, error) {
+	if len(condition) == 0 {
+		return nil, nil
+	}
+
+	conditions := ladon.Conditions{}
+	for operator, keys := range condition {
+		for key, values := range keys {
+			c, err := importCondition(operator, values)
+			if err != nil {
+				return nil, fmt.Errorf("condition %s on %s: %s", operator, key, err)
+			}
+			conditions.AddCondition(key, c)
+		}
+	}
+	return conditions, nil
+}
+
+// importCondition translates a single IAM condition operator into a ladon Condition,
+// covering every operator exportCondition can produce.
+func importCondition(operator string, values StringSet) (ladon.Condition, error) {
+	switch operator {
+	case "IpAddress":
+		if len(values) == 1 {
+			return &ladon.CIDRCondition{CIDR: values[0]}, nil
+		}
+		return &ladon.IpAddressCondition{CIDR: values}, nil
+	case "NotIpAddress":
+		return &ladon.NotIpAddressCondition{CIDR: values}, nil
+	case "StringEquals":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.StringEqualCondition{Equals: value}, nil
+	case "StringNotEquals":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.StringNotEqualsCondition{Equals: value}, nil
+	case "StringEqualsIgnoreCase":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.StringEqualsIgnoreCaseCondition{Equals: value}, nil
+	case "StringLike":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.StringLikeCondition{Pattern: value}, nil
+	case "StringNotLike":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.StringNotLikeCondition{Pattern: value}, nil
+	case "NumericEquals":
+		value, err := oneFloat(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.NumericEqualsCondition{Value: value}, nil
+	case "NumericNotEquals":
+		value, err := oneFloat(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.NumericNotEqualsCondition{Value: value}, nil
+	case "NumericLessThan":
+		value, err := oneFloat(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.NumericLessThanCondition{Value: value}, nil
+	case "NumericLessThanEquals":
+		value, err := oneFloat(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.NumericLessThanEqualsCondition{Value: value}, nil
+	case "NumericGreaterThan":
+		value, err := oneFloat(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.NumericGreaterThanCondition{Value: value}, nil
+	case "NumericGreaterThanEquals":
+		value, err := oneFloat(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.NumericGreaterThanEqualsCondition{Value: value}, nil
+	case "DateEquals":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.DateEqualsCondition{Value: value}, nil
+	case "DateLessThan":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.DateLessThanCondition{Value: value}, nil
+	case "DateGreaterThan":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		return &ladon.DateGreaterThanCondition{Value: value}, nil
+	case "Bool":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("Bool requires a boolean value, got %q", value)
+		}
+		return &ladon.BoolCondition{Value: b}, nil
+	case "ArnEquals", "ArnLike":
+		value, err := oneValue(operator, values)
+		if err != nil {
+			return nil, err
+		}
+		if operator == "ArnEquals" {
+			return &ladon.ArnEqualsCondition{Arn: value}, nil
+		}
+		return &ladon.ArnLikeCondition{Pattern: value}, nil
+	default:
+		return nil, fmt.Errorf("unsupported condition operator %q", operator)
+	}
+}
+
+// oneValue returns values[0], or an error if values doesn't contain exactly one entry.
+func oneValue(operator string, values StringSet) (string, error) {
+	if len(values) != 1 {
+		return "", fmt.Errorf("%s requires exactly one value, got %d", operator, len(values))
+	}
+	return values[0], nil
+}
+
+// oneFloat returns values[0] parsed as a float64, or an error if values doesn't contain
+// exactly one numeric entry.
+func oneFloat(operator string, values StringSet) (float64, error) {
+	value, err := oneValue(operator, values)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s requires a numeric value, got %q", operator, value)
+	}
+	return f, nil
+}
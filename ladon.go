@@ -0,0 +1,163 @@
+package ladon
+
+import "github.com/pkg/errors"
+
+// Warden decides whether a subject is allowed to perform an action on a resource.
+type Warden interface {
+	IsAllowed(r *Request) error
+}
+
+// Ladon is an implementation of Warden that checks an incoming Request against the
+// policies returned by Manager, with deny taking precedence over allow.
+type Ladon struct {
+	Manager     Manager
+	Matcher     Matcher
+	AuditLogger AuditLogger
+}
+
+// IsAllowed returns nil if the request is allowed by at least one policy and denied by
+// none, and an error otherwise. It is a thin wrapper over Evaluate for callers that only
+// care about the boolean outcome.
+func (l *Ladon) IsAllowed(r *Request) error {
+	decision, err := l.Evaluate(r)
+	if err != nil {
+		return err
+	}
+
+	if !decision.Allowed {
+		return errors.New(denialReason(decision))
+	}
+	return nil
+}
+
+// Evaluate checks r against every policy Manager considers a candidate and returns a
+// structured Decision recording, for each of them, whether it matched and why. The final
+// effect follows explicit-deny-overrides-allow-overrides-implicit-deny precedence.
+func (l *Ladon) Evaluate(r *Request) (*Decision, error) {
+	policies, err := l.Manager.FindRequestCandidates(r)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := &Decision{Request: r, Results: make([]PolicyResult, 0, len(policies))}
+
+	anyAllowed := false
+	anyDenied := false
+	for _, p := range policies {
+		result := l.evaluatePolicy(p, r)
+		decision.Results = append(decision.Results, result)
+
+		if !result.Matched {
+			continue
+		}
+		if p.AllowAccess() {
+			anyAllowed = true
+		} else {
+			anyDenied = true
+		}
+	}
+
+	decision.Allowed = anyAllowed && !anyDenied
+	l.auditLogger().LogIsAllowed(decision)
+	return decision, nil
+}
+
+// evaluatePolicy checks whether p applies to r, recording the reason when it doesn't.
+func (l *Ladon) evaluatePolicy(p Policy, r *Request) PolicyResult {
+	matcher := l.matcher()
+
+	if !matcher.Matches(p, p.GetSubjects(), r.Subject) {
+		return PolicyResult{Policy: p, Reason: "subject does not match Subjects"}
+	}
+	if len(p.GetNotSubjects()) > 0 && matcher.Matches(p, p.GetNotSubjects(), r.Subject) {
+		return PolicyResult{Policy: p, Reason: "subject matches NotSubjects"}
+	}
+
+	if !matcher.Matches(p, p.GetActions(), r.Action) {
+		return PolicyResult{Policy: p, Reason: "action does not match Actions"}
+	}
+	if len(p.GetNotActions()) > 0 && matcher.Matches(p, p.GetNotActions(), r.Action) {
+		return PolicyResult{Policy: p, Reason: "action matches NotActions"}
+	}
+
+	if !matcher.Matches(p, p.GetResources(), r.Resource) {
+		return PolicyResult{Policy: p, Reason: "resource does not match Resources"}
+	}
+	if len(p.GetNotResources()) > 0 && matcher.Matches(p, p.GetNotResources(), r.Resource) {
+		return PolicyResult{Policy: p, Reason: "resource matches NotResources"}
+	}
+
+	conditions, satisfied := l.evaluateConditions(p, r)
+	if !satisfied {
+		return PolicyResult{Policy: p, Conditions: conditions, Reason: "conditions were not satisfied"}
+	}
+
+	return PolicyResult{Policy: p, Matched: true, Conditions: conditions}
+}
+
+// evaluateConditions checks r's context against p's conditions, returning the per-condition
+// trace and whether the policy's conditions as a whole are satisfied: all of them by
+// default, or just one if p.MatchesAnyCondition() is true. Conditions see a nil value for
+// context keys that are absent from r, so e.g. NotDefinedCondition can match on their
+// absence.
+func (l *Ladon) evaluateConditions(p Policy, r *Request) ([]ConditionResult, bool) {
+	conditionMap := p.GetConditions()
+	if len(conditionMap) == 0 {
+		return nil, true
+	}
+
+	any := p.MatchesAnyCondition()
+	results := make([]ConditionResult, 0, len(conditionMap))
+	satisfied := !any
+
+	for key, condition := range conditionMap {
+		value := r.Context[key]
+		fulfilled := condition.Fulfills(value, r)
+
+		result := ConditionResult{Key: key, Fulfilled: fulfilled}
+		if !fulfilled {
+			if reasoning, ok := condition.(ReasoningCondition); ok {
+				result.Reason = reasoning.Reason(value)
+			}
+		}
+		results = append(results, result)
+
+		if fulfilled && any {
+			satisfied = true
+		}
+		if !fulfilled && !any {
+			satisfied = false
+		}
+	}
+
+	return results, satisfied
+}
+
+// denialReason renders a human-readable explanation of why decision was denied.
+func denialReason(decision *Decision) string {
+	for _, result := range decision.Results {
+		if result.Matched && !result.Policy.AllowAccess() {
+			return "request denied by policy " + result.Policy.GetID()
+		}
+	}
+	return "request denied by default"
+}
+
+// matcher returns l.Matcher, or a fresh DefaultMatcher if unset. It does not cache the
+// default back onto l: Ladon is a long-lived value shared across concurrent requests, and
+// writing to l.Matcher here without synchronization would race with concurrent reads.
+func (l *Ladon) matcher() Matcher {
+	if l.Matcher == nil {
+		return NewDefaultMatcher()
+	}
+	return l.Matcher
+}
+
+// auditLogger returns l.AuditLogger, or a no-op logger if unset. See matcher for why it
+// isn't cached back onto l.
+func (l *Ladon) auditLogger() AuditLogger {
+	if l.AuditLogger == nil {
+		return NoopAuditLogger{}
+	}
+	return l.AuditLogger
+}
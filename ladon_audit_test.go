@@ -0,0 +1,86 @@
+package ladon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingAuditLogger struct {
+	decisions []*Decision
+}
+
+func (l *recordingAuditLogger) LogIsAllowed(d *Decision) {
+	l.decisions = append(l.decisions, d)
+}
+
+func TestEvaluateRecordsWhyPoliciesDidNotMatch(t *testing.T) {
+	warden := &Ladon{Manager: NewMemoryManager()}
+	for _, pol := range pols {
+		require.Nil(t, warden.Manager.Create(pol))
+	}
+
+	decision, err := warden.Evaluate(&Request{
+		Subject:  "peter",
+		Action:   "delete",
+		Resource: "myrn:some.domain.com:resource:123",
+		Context: Context{
+			"owner":    "peter",
+			"clientIP": "0.0.0.0",
+		},
+	})
+
+	require.Nil(t, err)
+	assert.False(t, decision.Allowed)
+
+	var policy1 PolicyResult
+	for _, result := range decision.Results {
+		if result.Policy.GetID() == "1" {
+			policy1 = result
+		}
+	}
+
+	assert.False(t, policy1.Matched)
+	require.NotEmpty(t, policy1.Conditions)
+
+	found := false
+	for _, c := range policy1.Conditions {
+		if c.Key == "clientIP" {
+			found = true
+			assert.False(t, c.Fulfilled)
+			assert.Equal(t, "value 0.0.0.0 is not an IP address within 127.0.0.1/32", c.Reason)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestIsAllowedFulfillsNotDefinedConditionOnAbsentKey(t *testing.T) {
+	warden := &Ladon{Manager: NewMemoryManager()}
+	require.Nil(t, warden.Manager.Create(&DefaultPolicy{
+		ID:        "absent-banned-flag",
+		Subjects:  []string{"max"},
+		Actions:   []string{"get"},
+		Resources: []string{"<.*>"},
+		Effect:    AllowAccess,
+		Conditions: Conditions{
+			"banned": &NotDefinedCondition{},
+		},
+	}))
+
+	assert.Nil(t, warden.IsAllowed(&Request{Subject: "max", Action: "get", Resource: "x"}))
+	assert.NotNil(t, warden.IsAllowed(&Request{
+		Subject: "max", Action: "get", Resource: "x",
+		Context: Context{"banned": true},
+	}))
+}
+
+func TestLadonInvokesAuditLogger(t *testing.T) {
+	logger := &recordingAuditLogger{}
+	warden := &Ladon{Manager: NewMemoryManager(), AuditLogger: logger}
+	require.Nil(t, warden.Manager.Create(pols[1]))
+
+	require.Nil(t, warden.IsAllowed(&Request{Subject: "max", Action: "update", Resource: "x"}))
+	require.Len(t, logger.decisions, 1)
+	assert.True(t, logger.decisions[0].Allowed)
+}
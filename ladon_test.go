@@ -66,6 +66,38 @@ var pols = []Policy{
 			},
 		},
 	},
+	&DefaultPolicy{
+		ID:          "6",
+		Description: "This policy allows ken to broadcast on all resources",
+		Subjects:    []string{"ken"},
+		Actions:     []string{"broadcast"},
+		Resources:   []string{"<.*>"},
+		Effect:      AllowAccess,
+	},
+	&DefaultPolicy{
+		ID:           "7",
+		Description:  "This policy denies ken to broadcast on all resources except myrn:public:<.*>",
+		Subjects:     []string{"ken"},
+		Actions:      []string{"broadcast"},
+		Resources:    []string{"<.*>"},
+		NotResources: []string{"myrn:public:<.*>"},
+		Effect:       DenyAccess,
+	},
+	&DefaultPolicy{
+		ID:          "8",
+		Description: "This policy allows grace to create files if she acts on behalf of a user with admin role, or if a vip flag was provided",
+		Subjects:    []string{"grace"},
+		Actions:     []string{"create"},
+		Resources:   []string{"<.*>"},
+		Effect:      AllowAccess,
+		Conditions: Conditions{
+			"role": &StringEqualCondition{
+				Equals: "admin",
+			},
+			"vip": &DefinedCondition{},
+		},
+		AnyCondition: true,
+	},
 }
 
 // Some test cases
@@ -206,6 +238,49 @@ var cases = []struct {
 		},
 		expectErr: true,
 	},
+	{
+		description: "should pass because policy 7's NotResources excludes myrn:public:<.*>, leaving policy 6's allow in effect",
+		accessRequest: &Request{
+			Subject:  "ken",
+			Action:   "broadcast",
+			Resource: "myrn:public:announcements",
+		},
+		expectErr: false,
+	},
+	{
+		description: "should fail because policy 7 denies ken to broadcast on any resource not excluded by NotResources",
+		accessRequest: &Request{
+			Subject:  "ken",
+			Action:   "broadcast",
+			Resource: "myrn:private:announcements",
+		},
+		expectErr: true,
+	},
+	{
+		description: "should pass because policy 8's AnyCondition is satisfied by vip alone, even though role doesn't equal admin",
+		accessRequest: &Request{
+			Subject:  "grace",
+			Action:   "create",
+			Resource: "urn:dome.domain.com:file:1",
+			Context: Context{
+				"role": "user",
+				"vip":  true,
+			},
+		},
+		expectErr: false,
+	},
+	{
+		description: "should fail because policy 8's AnyCondition is not satisfied by either role or vip",
+		accessRequest: &Request{
+			Subject:  "grace",
+			Action:   "create",
+			Resource: "urn:dome.domain.com:file:1",
+			Context: Context{
+				"role": "user",
+			},
+		},
+		expectErr: true,
+	},
 }
 
 func TestLadon(t *testing.T) {
@@ -0,0 +1,27 @@
+package ladon
+
+// Policies is a collection of policies.
+type Policies []Policy
+
+// Manager is responsible for storing and retrieving policies.
+type Manager interface {
+	// Create persists a new policy.
+	Create(policy Policy) error
+
+	// Update updates an existing policy.
+	Update(policy Policy) error
+
+	// Get retrieves a policy by id.
+	Get(id string) (Policy, error)
+
+	// Delete removes a policy by id.
+	Delete(id string) error
+
+	// GetAll returns all policies, honoring limit and offset.
+	GetAll(limit, offset int64) (Policies, error)
+
+	// FindRequestCandidates returns the policies that could possibly apply to r, without
+	// evaluating subjects, actions, resources or conditions. The warden is responsible for
+	// the actual matching.
+	FindRequestCandidates(r *Request) (Policies, error)
+}
@@ -0,0 +1,96 @@
+package ladon
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// MemoryManager is an in-memory (non-persistent) implementation of Manager, useful for
+// tests and small deployments.
+type MemoryManager struct {
+	Policies map[string]Policy
+	sync.RWMutex
+}
+
+// NewMemoryManager returns an empty MemoryManager.
+func NewMemoryManager() *MemoryManager {
+	return &MemoryManager{Policies: map[string]Policy{}}
+}
+
+// Create persists a new policy.
+func (m *MemoryManager) Create(policy Policy) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, found := m.Policies[policy.GetID()]; found {
+		return errors.Errorf("policy with id %s already exists", policy.GetID())
+	}
+
+	m.Policies[policy.GetID()] = policy
+	return nil
+}
+
+// Update updates an existing policy.
+func (m *MemoryManager) Update(policy Policy) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.Policies[policy.GetID()] = policy
+	return nil
+}
+
+// Get retrieves a policy by id.
+func (m *MemoryManager) Get(id string) (Policy, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	p, ok := m.Policies[id]
+	if !ok {
+		return nil, errors.Errorf("policy %s not found", id)
+	}
+	return p, nil
+}
+
+// Delete removes a policy by id.
+func (m *MemoryManager) Delete(id string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.Policies, id)
+	return nil
+}
+
+// GetAll returns all policies, honoring limit and offset.
+func (m *MemoryManager) GetAll(limit, offset int64) (Policies, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	all := make(Policies, 0, len(m.Policies))
+	for _, p := range m.Policies {
+		all = append(all, p)
+	}
+
+	start := offset
+	if start > int64(len(all)) {
+		start = int64(len(all))
+	}
+	end := start + limit
+	if limit <= 0 || end > int64(len(all)) {
+		end = int64(len(all))
+	}
+	return all[start:end], nil
+}
+
+// FindRequestCandidates returns every stored policy; MemoryManager is too small to
+// benefit from narrowing the candidate set further.
+func (m *MemoryManager) FindRequestCandidates(r *Request) (Policies, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	all := make(Policies, 0, len(m.Policies))
+	for _, p := range m.Policies {
+		all = append(all, p)
+	}
+	return all, nil
+}
@@ -0,0 +1,92 @@
+package ladon
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Matcher matches a haystack of patterns against a needle.
+type Matcher interface {
+	Matches(p Policy, haystack []string, needle string) bool
+}
+
+// DefaultMatcher matches patterns that may embed a regular expression delimited by
+// '<' and '>', e.g. "myrn:some.domain.com:resource:<.+>".
+type DefaultMatcher struct {
+	compiled map[string]*regexp.Regexp
+	mu       sync.RWMutex
+}
+
+// NewDefaultMatcher returns a DefaultMatcher with an empty regex cache.
+func NewDefaultMatcher() *DefaultMatcher {
+	return &DefaultMatcher{compiled: map[string]*regexp.Regexp{}}
+}
+
+// Matches returns true if needle matches any pattern in haystack.
+func (m *DefaultMatcher) Matches(p Policy, haystack []string, needle string) bool {
+	for _, pattern := range haystack {
+		if pattern == needle {
+			return true
+		}
+
+		reg, err := m.regexp(pattern)
+		if err != nil {
+			continue
+		}
+
+		if reg.MatchString(needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// regexp compiles pattern into a regular expression anchored over the whole string,
+// caching the result for subsequent lookups.
+func (m *DefaultMatcher) regexp(pattern string) (*regexp.Regexp, error) {
+	m.mu.RLock()
+	reg, ok := m.compiled[pattern]
+	m.mu.RUnlock()
+	if ok {
+		return reg, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if reg, ok := m.compiled[pattern]; ok {
+		return reg, nil
+	}
+
+	reg, err := regexp.Compile("^" + compileRegex(pattern) + "$")
+	if err != nil {
+		return nil, err
+	}
+	m.compiled[pattern] = reg
+	return reg, nil
+}
+
+// compileRegex quotes every part of pattern outside of '<' '>' delimiters, so that only
+// the delimited parts are treated as regular expressions.
+func compileRegex(pattern string) string {
+	var b strings.Builder
+	for len(pattern) > 0 {
+		start := strings.Index(pattern, "<")
+		if start == -1 {
+			b.WriteString(regexp.QuoteMeta(pattern))
+			break
+		}
+
+		end := strings.Index(pattern[start:], ">")
+		if end == -1 {
+			b.WriteString(regexp.QuoteMeta(pattern))
+			break
+		}
+		end += start
+
+		b.WriteString(regexp.QuoteMeta(pattern[:start]))
+		b.WriteString("(" + pattern[start+1:end] + ")")
+		pattern = pattern[end+1:]
+	}
+	return b.String()
+}
@@ -0,0 +1,131 @@
+package ladon
+
+const (
+	// AllowAccess is the allow access effect.
+	AllowAccess = "allow"
+
+	// DenyAccess is the deny access effect.
+	DenyAccess = "deny"
+)
+
+// Policy represents a policy model.
+type Policy interface {
+	// GetID returns the policy's id.
+	GetID() string
+
+	// GetDescription returns the policy's description.
+	GetDescription() string
+
+	// GetSubjects returns the policy's subjects.
+	GetSubjects() []string
+
+	// GetNotSubjects returns the policy's excluded subjects: even if a subject matches
+	// GetSubjects(), the policy does not apply if it also matches one of these.
+	GetNotSubjects() []string
+
+	// GetActions returns the policy's actions.
+	GetActions() []string
+
+	// GetNotActions returns the policy's excluded actions.
+	GetNotActions() []string
+
+	// GetResources returns the policy's resources.
+	GetResources() []string
+
+	// GetNotResources returns the policy's excluded resources.
+	GetNotResources() []string
+
+	// GetEffect returns the policy's effect, which is either AllowAccess or DenyAccess.
+	GetEffect() string
+
+	// AllowAccess returns true if the policy's effect is AllowAccess.
+	AllowAccess() bool
+
+	// GetConditions returns the policy's conditions.
+	GetConditions() Conditions
+
+	// MatchesAnyCondition returns true if only one of the policy's conditions needs to be
+	// fulfilled, rather than all of them.
+	MatchesAnyCondition() bool
+}
+
+// DefaultPolicy is the default (de)serializable implementation of Policy.
+type DefaultPolicy struct {
+	ID           string     `json:"id"`
+	Description  string     `json:"description"`
+	Subjects     []string   `json:"subjects"`
+	NotSubjects  []string   `json:"notSubjects,omitempty"`
+	Effect       string     `json:"effect"`
+	Resources    []string   `json:"resources"`
+	NotResources []string   `json:"notResources,omitempty"`
+	Actions      []string   `json:"actions"`
+	NotActions   []string   `json:"notActions,omitempty"`
+	Conditions   Conditions `json:"conditions"`
+
+	// AnyCondition, when true, requires only one of Conditions to match instead of all of
+	// them.
+	AnyCondition bool `json:"anyCondition,omitempty"`
+}
+
+// GetID returns the policy's id.
+func (p *DefaultPolicy) GetID() string {
+	return p.ID
+}
+
+// GetDescription returns the policy's description.
+func (p *DefaultPolicy) GetDescription() string {
+	return p.Description
+}
+
+// GetSubjects returns the policy's subjects.
+func (p *DefaultPolicy) GetSubjects() []string {
+	return p.Subjects
+}
+
+// GetNotSubjects returns the policy's excluded subjects.
+func (p *DefaultPolicy) GetNotSubjects() []string {
+	return p.NotSubjects
+}
+
+// GetActions returns the policy's actions.
+func (p *DefaultPolicy) GetActions() []string {
+	return p.Actions
+}
+
+// GetNotActions returns the policy's excluded actions.
+func (p *DefaultPolicy) GetNotActions() []string {
+	return p.NotActions
+}
+
+// GetResources returns the policy's resources.
+func (p *DefaultPolicy) GetResources() []string {
+	return p.Resources
+}
+
+// GetNotResources returns the policy's excluded resources.
+func (p *DefaultPolicy) GetNotResources() []string {
+	return p.NotResources
+}
+
+// GetEffect returns the policy's effect.
+func (p *DefaultPolicy) GetEffect() string {
+	return p.Effect
+}
+
+// AllowAccess returns true if the policy's effect is AllowAccess.
+func (p *DefaultPolicy) AllowAccess() bool {
+	return p.Effect == AllowAccess
+}
+
+// GetConditions returns the policy's conditions.
+func (p *DefaultPolicy) GetConditions() Conditions {
+	if p.Conditions == nil {
+		return Conditions{}
+	}
+	return p.Conditions
+}
+
+// MatchesAnyCondition returns p.AnyCondition.
+func (p *DefaultPolicy) MatchesAnyCondition() bool {
+	return p.AnyCondition
+}
@@ -0,0 +1,19 @@
+package ladon
+
+// Request is the warden's request object.
+type Request struct {
+	// Resource is the resource that access is requested to.
+	Resource string `json:"resource"`
+
+	// Action is the action that is requested on the resource.
+	Action string `json:"action"`
+
+	// Subject is the subject that is requesting access.
+	Subject string `json:"subject"`
+
+	// Context is the request's environmental context.
+	Context Context `json:"context"`
+}
+
+// Context holds additional information about the request that conditions can be evaluated against.
+type Context map[string]interface{}